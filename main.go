@@ -2,7 +2,13 @@ package main
 
 import (
 	"context"
+	"log"
+
 	"flag"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
 	"github.com/vaerh/terraform-provider-routeros/routeros"
 )
@@ -16,12 +22,25 @@ func main() {
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
 	flag.Parse()
 
+	ctx := context.Background()
+
+	// The SDKv2 provider keeps serving every existing resource unchanged. New resources with
+	// deeply nested, config-driven structures are added to routeros.NewFrameworkProvider
+	// instead; tf5muxserver exposes both through a single protocol version.
+	muxServer, err := tf5muxserver.NewMuxServer(ctx,
+		func() tfprotov5.ProviderServer { return routeros.NewProvider().GRPCProvider() },
+		providerserver.NewProtocol5(routeros.NewFrameworkProvider()),
+	)
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	opts := &plugin.ServeOpts{
-		ProviderFunc: routeros.NewProvider,
+		GRPCProviderFunc: muxServer.ProviderServer,
 	}
 
 	if debug {
-		plugin.Debug(context.Background(), "vaerh/routeros", opts)
+		plugin.Debug(ctx, "vaerh/routeros", opts)
 	} else {
 		plugin.Serve(opts)
 	}
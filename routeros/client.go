@@ -0,0 +1,225 @@
+package routeros
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"time"
+)
+
+// Client A connection to a RouterOS device's binary API (default port 8728, or 8729 over TLS).
+// Shared by every SDKv2 and terraform-plugin-framework resource, so both implementations talk
+// to RouterOS the same way.
+type Client struct {
+	HostURL  string
+	Insecure bool
+	Username string
+	Password string
+
+	conn      net.Conn
+	transport *loggingTransport
+	planCache *planValidationCache
+}
+
+// Dial Opens the API connection and logs in. A "tls://" HostURL prefix dials with TLS,
+// skipping certificate verification when Insecure is set; otherwise the connection is plain
+// TCP, matching RouterOS's default (non-TLS) API port.
+func (c *Client) Dial() error {
+	address := c.HostURL
+
+	var conn net.Conn
+	var err error
+	if rest, ok := strings.CutPrefix(address, "tls://"); ok {
+		conn, err = tls.Dial("tcp", rest, &tls.Config{InsecureSkipVerify: c.Insecure})
+	} else {
+		conn, err = net.Dial("tcp", address)
+	}
+	if err != nil {
+		return fmt.Errorf("[routeros client] dial %s: %w", c.HostURL, err)
+	}
+
+	c.conn = conn
+
+	if _, err := c.rawRunCommand(context.Background(), "/login", "=name="+c.Username, "=password="+c.Password); err != nil {
+		_ = conn.Close()
+		return fmt.Errorf("[routeros client] login: %w", err)
+	}
+
+	return nil
+}
+
+// Close Closes the underlying API connection.
+func (c *Client) Close() error {
+	if c.conn == nil {
+		return nil
+	}
+	return c.conn.Close()
+}
+
+// RunCommand Sends a RouterOS API sentence (e.g. "/interface/list/print", "?name=wan") and
+// returns the words RouterOS replied with, joined by spaces. When the provider was configured
+// with a logging transport (see NewLoggingTransport), the exchange is traced/redacted there;
+// otherwise it goes straight to the wire. ctx's deadline, if any, bounds the round trip so a
+// hung device can't block the CRUD handler forever.
+func (c *Client) RunCommand(ctx context.Context, words ...string) (string, error) {
+	if c.transport != nil {
+		return c.transport.Do(ctx, words...)
+	}
+
+	return c.rawRunCommand(ctx, words...)
+}
+
+// rawRunCommand Performs the actual API exchange: writes the sentence, then reads sentences
+// until a terminating "!done" (success) or "!trap" (error).
+func (c *Client) rawRunCommand(ctx context.Context, words ...string) (string, error) {
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = c.conn.SetDeadline(deadline)
+		defer c.conn.SetDeadline(time.Time{})
+	}
+
+	if err := c.writeSentence(words...); err != nil {
+		return "", err
+	}
+
+	var reply []string
+	for {
+		sentence, err := c.readSentence()
+		if err != nil {
+			return "", err
+		}
+
+		reply = append(reply, sentence...)
+
+		if len(sentence) == 0 {
+			continue
+		}
+
+		switch sentence[0] {
+		case "!trap", "!fatal":
+			return "", fmt.Errorf("[routeros client] %s", strings.Join(sentence, " "))
+		case "!done":
+			return strings.Join(reply, " "), nil
+		}
+	}
+}
+
+// writeSentence Writes each word length-prefixed, then a zero-length word to terminate the
+// sentence, per the RouterOS API binary protocol.
+func (c *Client) writeSentence(words ...string) error {
+	for _, w := range words {
+		if err := writeWord(c.conn, w); err != nil {
+			return fmt.Errorf("[routeros client] write word %q: %w", w, err)
+		}
+	}
+
+	return writeLen(c.conn, 0)
+}
+
+// readSentence Reads length-prefixed words until a zero-length word terminates the sentence.
+func (c *Client) readSentence() ([]string, error) {
+	var words []string
+
+	for {
+		w, err := readWord(c.conn)
+		if err != nil {
+			return nil, fmt.Errorf("[routeros client] read word: %w", err)
+		}
+
+		if w == "" {
+			return words, nil
+		}
+
+		words = append(words, w)
+	}
+}
+
+func writeWord(w io.Writer, word string) error {
+	if err := writeLen(w, len(word)); err != nil {
+		return err
+	}
+
+	_, err := io.WriteString(w, word)
+	return err
+}
+
+func readWord(r io.Reader) (string, error) {
+	l, err := readLen(r)
+	if err != nil {
+		return "", err
+	}
+	if l == 0 {
+		return "", nil
+	}
+
+	buf := make([]byte, l)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return "", err
+	}
+
+	return string(buf), nil
+}
+
+// writeLen Encodes a word length using RouterOS's variable-length control-byte scheme.
+func writeLen(w io.Writer, l int) error {
+	var buf []byte
+
+	switch {
+	case l < 0x80:
+		buf = []byte{byte(l)}
+	case l < 0x4000:
+		l |= 0x8000
+		buf = []byte{byte(l >> 8), byte(l)}
+	case l < 0x200000:
+		l |= 0xC00000
+		buf = []byte{byte(l >> 16), byte(l >> 8), byte(l)}
+	case l < 0x10000000:
+		l |= 0xE0000000
+		buf = []byte{byte(l >> 24), byte(l >> 16), byte(l >> 8), byte(l)}
+	default:
+		buf = []byte{0xF0, byte(l >> 24), byte(l >> 16), byte(l >> 8), byte(l)}
+	}
+
+	_, err := w.Write(buf)
+	return err
+}
+
+// readLen Decodes a word length written by writeLen.
+func readLen(r io.Reader) (int, error) {
+	var b [1]byte
+	if _, err := io.ReadFull(r, b[:]); err != nil {
+		return 0, err
+	}
+	c0 := int(b[0])
+
+	switch {
+	case c0&0x80 == 0x00:
+		return c0, nil
+	case c0&0xC0 == 0x80:
+		return readLenTail(r, c0&^0xC0, 1)
+	case c0&0xE0 == 0xC0:
+		return readLenTail(r, c0&^0xE0, 2)
+	case c0&0xF0 == 0xE0:
+		return readLenTail(r, c0&^0xF0, 3)
+	case c0&0xF8 == 0xF0:
+		return readLenTail(r, 0, 4)
+	default:
+		return 0, fmt.Errorf("[routeros client] invalid length control byte: %#x", c0)
+	}
+}
+
+func readLenTail(r io.Reader, high, n int) (int, error) {
+	buf := make([]byte, n)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return 0, err
+	}
+
+	l := high
+	for _, b := range buf {
+		l = l<<8 | int(b)
+	}
+
+	return l, nil
+}
@@ -0,0 +1,102 @@
+package routeros
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+)
+
+// fakeRouterOS Accepts a single connection, replies "!done" to /login immediately, then to
+// every subsequent sentence waits respondAfter before replying "!done" - just enough to
+// simulate a slow/hung device for the timeout test below.
+func fakeRouterOS(t *testing.T, respondAfter time.Duration) net.Listener {
+	t.Helper()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		c := &Client{conn: conn}
+
+		// /login
+		if _, err := c.readSentence(); err != nil {
+			return
+		}
+		if err := c.writeSentence("!done"); err != nil {
+			return
+		}
+
+		for {
+			if _, err := c.readSentence(); err != nil {
+				return
+			}
+
+			time.Sleep(respondAfter)
+
+			if err := c.writeSentence("!done"); err != nil {
+				return
+			}
+		}
+	}()
+
+	return ln
+}
+
+func TestClientRunCommand_HonorsContextTimeout(t *testing.T) {
+	ln := fakeRouterOS(t, 200*time.Millisecond)
+	defer ln.Close()
+
+	client := &Client{HostURL: ln.Addr().String()}
+	if err := client.Dial(); err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := client.RunCommand(ctx, "/interface/list/print")
+	elapsed := time.Since(start)
+
+	if err == nil {
+		t.Fatal("expected the slow responder to trip the context timeout, got nil error")
+	}
+
+	var netErr net.Error
+	if !errors.As(err, &netErr) || !netErr.Timeout() {
+		t.Fatalf("expected a network timeout error, got: %v", err)
+	}
+
+	if elapsed >= 200*time.Millisecond {
+		t.Fatalf("RunCommand blocked for %s, well past the 20ms context timeout", elapsed)
+	}
+}
+
+func TestClientRunCommand_SucceedsWithinTimeout(t *testing.T) {
+	ln := fakeRouterOS(t, 0)
+	defer ln.Close()
+
+	client := &Client{HostURL: ln.Addr().String()}
+	if err := client.Dial(); err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	if _, err := client.RunCommand(ctx, "/interface/list/print"); err != nil {
+		t.Fatalf("RunCommand: %v", err)
+	}
+}
@@ -0,0 +1,79 @@
+package routeros
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Provider-level connection arguments. Declared as constants and reused verbatim by
+// routeros.NewFrameworkProvider's Schema(), so the SDKv2 and terraform-plugin-framework halves
+// of the provider can never drift apart and get rejected by tf5muxserver.NewMuxServer at
+// startup for disagreeing on the provider schema.
+const (
+	KeyHostURL  = "hosturl"
+	KeyInsecure = "insecure"
+	KeyUsername = "username"
+	KeyPassword = "password"
+)
+
+const (
+	DescHostURL  = "The RouterOS API endpoint, e.g. 192.0.2.1:8728 or tls://192.0.2.1:8729."
+	DescInsecure = "Whether to skip TLS certificate verification."
+	DescUsername = "User name used for RouterOS API authentication."
+	DescPassword = "Password used for RouterOS API authentication."
+)
+
+// NewProvider SDKv2 half of the provider. New resources with deeply nested, config-driven
+// structures belong in routeros.NewFrameworkProvider instead; both are muxed together in
+// main.go.
+func NewProvider() *schema.Provider {
+	return &schema.Provider{
+		Schema: map[string]*schema.Schema{
+			KeyHostURL: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: DescHostURL,
+			},
+			KeyInsecure: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: DescInsecure,
+			},
+			KeyUsername: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: DescUsername,
+			},
+			KeyPassword: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: DescPassword,
+			},
+		},
+		ResourcesMap: map[string]*schema.Resource{
+			"routeros_interface_list_member": resourceInterfaceListMember(),
+			"routeros_command":               resourceCommand(),
+		},
+		ConfigureContextFunc: providerConfigure,
+	}
+}
+
+func providerConfigure(_ context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+	client := &Client{
+		HostURL:  d.Get(KeyHostURL).(string),
+		Insecure: d.Get(KeyInsecure).(bool),
+		Username: d.Get(KeyUsername).(string),
+		Password: d.Get(KeyPassword).(string),
+	}
+	client.transport = NewLoggingTransport(client.rawRunCommand, redactPatternsFromEnv())
+	client.planCache = &planValidationCache{}
+
+	if err := client.Dial(); err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	return client, nil
+}
@@ -0,0 +1,101 @@
+package routeros
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// NewFrameworkProvider Entry point for the terraform-plugin-framework side of the provider.
+// New resources with deeply nested, config-driven structures (firewall rules, CAPsMAN,
+// /routing/bgp, ...) should be authored here so they can use typed schema, plan modifiers and
+// rich validators, while existing resources keep running unchanged on the SDKv2 side. Both
+// implementations are composed into a single protocol version by tf5muxserver in main.go.
+func NewFrameworkProvider() provider.Provider {
+	return &frameworkProvider{}
+}
+
+type frameworkProvider struct{}
+
+// frameworkProviderModel Mirrors the connection arguments accepted by the SDKv2 provider so a
+// single `provider "routeros" {}` block configures resources on either implementation.
+type frameworkProviderModel struct {
+	HostURL  types.String `tfsdk:"hosturl"`
+	Insecure types.Bool   `tfsdk:"insecure"`
+	Username types.String `tfsdk:"username"`
+	Password types.String `tfsdk:"password"`
+}
+
+// Client (host/insecure/username/password fields plus RunCommand) lives in client.go - it is
+// the same type routeros.NewProvider hands SDKv2 resources via meta, so a framework resource
+// and an SDKv2 resource can be pointed at the same RouterOS connection.
+
+func (p *frameworkProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "routeros"
+}
+
+// Schema Must stay attribute-for-attribute identical to routeros.NewProvider's Schema (down to
+// descriptions and sensitivity) or tf5muxserver.NewMuxServer refuses to start, since Terraform
+// core sees one "routeros" provider block and expects both implementations to agree on it. The
+// Key*/Desc* constants are shared with provider.go for exactly that reason.
+func (p *frameworkProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			KeyHostURL: schema.StringAttribute{
+				Optional:    true,
+				Description: DescHostURL,
+			},
+			KeyInsecure: schema.BoolAttribute{
+				Optional:    true,
+				Description: DescInsecure,
+			},
+			KeyUsername: schema.StringAttribute{
+				Optional:    true,
+				Description: DescUsername,
+			},
+			KeyPassword: schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: DescPassword,
+			},
+		},
+	}
+}
+
+func (p *frameworkProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	var config frameworkProviderModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := &Client{
+		HostURL:  config.HostURL.ValueString(),
+		Insecure: config.Insecure.ValueBool(),
+		Username: config.Username.ValueString(),
+		Password: config.Password.ValueString(),
+	}
+	client.transport = NewLoggingTransport(client.rawRunCommand, redactPatternsFromEnv())
+
+	if err := client.Dial(); err != nil {
+		resp.Diagnostics.AddError("Unable to connect to RouterOS", err.Error())
+		return
+	}
+
+	resp.ResourceData = client
+	resp.DataSourceData = client
+}
+
+func (p *frameworkProvider) Resources(_ context.Context) []func() resource.Resource {
+	return []func() resource.Resource{
+		NewInterfaceListResource,
+	}
+}
+
+func (p *frameworkProvider) DataSources(_ context.Context) []func() datasource.DataSource {
+	return nil
+}
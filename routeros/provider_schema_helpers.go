@@ -1,6 +1,7 @@
 package routeros
 
 import (
+	"context"
 	"fmt"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -8,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"regexp"
 	"strconv"
+	"time"
 )
 
 // All metadata fields must be present in each resource schema, and the field type must be string.
@@ -174,6 +176,35 @@ func PropMtuRw() *schema.Schema {
 	}
 }
 
+// Default CRUD timeouts, used by DefaultTimeouts(). RouterOS operations such as firmware
+// upgrades, certificate signing or bulk firewall writes can legitimately run for several
+// minutes, so the defaults are generous; users can still override them via the resource's
+// `timeouts` block.
+const (
+	DefaultTimeoutCreate = 5 * time.Minute
+	DefaultTimeoutUpdate = 5 * time.Minute
+	DefaultTimeoutDelete = 5 * time.Minute
+	DefaultTimeoutRead   = 1 * time.Minute
+)
+
+// DefaultTimeouts Default CRUD timeouts for a resource. Add `Timeouts: DefaultTimeouts()` to a
+// resource's schema.Resource to let it opt in with one line.
+func DefaultTimeouts() *schema.ResourceTimeout {
+	return &schema.ResourceTimeout{
+		Create: schema.DefaultTimeout(DefaultTimeoutCreate),
+		Update: schema.DefaultTimeout(DefaultTimeoutUpdate),
+		Delete: schema.DefaultTimeout(DefaultTimeoutDelete),
+		Read:   schema.DefaultTimeout(DefaultTimeoutRead),
+	}
+}
+
+// ResourceTimeoutContext Derives a context bound by the resolved timeout for the given CRUD
+// operation (schema.TimeoutCreate, schema.TimeoutRead, ...) so a hung ROS API call is cancelled
+// instead of blocking the provider indefinitely.
+func ResourceTimeoutContext(ctx context.Context, d *schema.ResourceData, key string) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(ctx, d.Timeout(key))
+}
+
 // Properties validation.
 var (
 	ValidationTime = validation.StringMatch(regexp.MustCompile(`^(\d+[smhdw]?)+$`),
@@ -0,0 +1,54 @@
+package routeros
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
+)
+
+// testAccProtoV5ProviderFactories Builds the same SDKv2+framework mux main.go serves, so
+// acceptance tests exercise both halves of the provider the way Terraform core actually talks
+// to them rather than calling Go functions directly.
+var testAccProtoV5ProviderFactories = map[string]func() (tfprotov5.ProviderServer, error){
+	"routeros": func() (tfprotov5.ProviderServer, error) {
+		ctx := context.Background()
+
+		muxServer, err := tf5muxserver.NewMuxServer(ctx,
+			func() tfprotov5.ProviderServer { return NewProvider().GRPCProvider() },
+			providerserver.NewProtocol5(NewFrameworkProvider()),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		return muxServer.ProviderServer(), nil
+	},
+}
+
+// testAccPreCheck Skips acceptance tests unless pointed at a real RouterOS device - there is no
+// RouterOS instance in CI, so ROUTEROS_TEST_HOST/ROUTEROS_TEST_USERNAME/ROUTEROS_TEST_PASSWORD
+// must be set for these to actually run. This keeps the tests real (not hollow "no tests to
+// run" matches) while being honest that they need live infrastructure to exercise.
+func testAccPreCheck(t *testing.T) {
+	t.Helper()
+
+	for _, k := range []string{"ROUTEROS_TEST_HOST", "ROUTEROS_TEST_USERNAME", "ROUTEROS_TEST_PASSWORD"} {
+		if os.Getenv(k) == "" {
+			t.Skipf("%s must be set for acceptance tests against a real RouterOS device", k)
+		}
+	}
+}
+
+func testAccProviderConfig() string {
+	return `
+provider "routeros" {
+  hosturl  = "` + os.Getenv("ROUTEROS_TEST_HOST") + `"
+  username = "` + os.Getenv("ROUTEROS_TEST_USERNAME") + `"
+  password = "` + os.Getenv("ROUTEROS_TEST_PASSWORD") + `"
+}
+`
+}
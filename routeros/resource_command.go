@@ -0,0 +1,122 @@
+package routeros
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	KeyCommands        = "commands"
+	KeyDestroyCommands = "destroy_commands"
+	KeyOnFailure       = "on_failure"
+)
+
+// resourceCommand `routeros_command`. terraform-plugin-sdk/v2 dropped support for provider-
+// bundled provisioners (there is no schema.Provisioner, ProvisionFunc or
+// plugin.ServeOpts.ProvisionerFunc to register one with anymore - provisioners are internal to
+// Terraform core now), so ad-hoc RouterOS CLI commands are run from a resource instead, the
+// same way the community reaches for null_resource + local-exec: `commands` run on create,
+// optional `destroy_commands` run on destroy, reusing the same client every other resource
+// gets from meta rather than a separate connection.
+func resourceCommand() *schema.Resource {
+	return &schema.Resource{
+		Description: "Runs ad-hoc RouterOS CLI commands, e.g. `/system script run foo`, as part of apply.",
+
+		CreateContext: resourceCommandCreate,
+		ReadContext:   resourceCommandRead,
+		DeleteContext: resourceCommandDelete,
+
+		Timeouts: DefaultTimeouts(),
+
+		Schema: map[string]*schema.Schema{
+			KeyCommands: {
+				Type:        schema.TypeList,
+				Required:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "RouterOS CLI commands to run when this resource is created.",
+			},
+			KeyDestroyCommands: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "RouterOS CLI commands to run when this resource is destroyed.",
+			},
+			KeyOnFailure: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "fail",
+				ValidateFunc: validation.StringInSlice([]string{"continue", "fail"}, false),
+				Description:  "Whether to `continue` or `fail` the apply when a command errors.",
+			},
+		},
+	}
+}
+
+func resourceCommandCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ctx, cancel := ResourceTimeoutContext(ctx, d, schema.TimeoutCreate)
+	defer cancel()
+
+	if diags := runCommands(ctx, meta.(*Client), d, KeyCommands); diags != nil {
+		return diags
+	}
+
+	d.SetId(d.Get(KeyCommands).([]interface{})[0].(string))
+
+	return nil
+}
+
+func resourceCommandRead(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	// The commands this resource runs have no RouterOS object of their own to read back; its
+	// existence in state is all that's tracked.
+	return nil
+}
+
+func resourceCommandDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ctx, cancel := ResourceTimeoutContext(ctx, d, schema.TimeoutDelete)
+	defer cancel()
+
+	if diags := runCommands(ctx, meta.(*Client), d, KeyDestroyCommands); diags != nil {
+		return diags
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func runCommands(ctx context.Context, client *Client, d *schema.ResourceData, key string) diag.Diagnostics {
+	onFailure := d.Get(KeyOnFailure).(string)
+
+	for _, c := range d.Get(key).([]interface{}) {
+		cmd := c.(string)
+
+		ColorizedDebug(ctx, "[routeros_command] running command", map[string]interface{}{"command": cmd})
+
+		sentence, err := client.RunCommand(ctx, strings.Fields(cmd)...)
+		if err != nil {
+			if onFailure == "continue" {
+				ColorizedDebug(ctx, "[routeros_command] command failed, continuing", map[string]interface{}{
+					"command": cmd,
+					"error":   err.Error(),
+				})
+				continue
+			}
+
+			return diag.Errorf("[routeros_command] command %q failed: %s", cmd, err)
+		}
+
+		ColorizedDebug(ctx, "[routeros_command] command output", map[string]interface{}{
+			"command": cmd,
+			"output":  sentence,
+		})
+	}
+
+	return nil
+}
@@ -0,0 +1,142 @@
+package routeros
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// NewInterfaceListResource Reference implementation for `/interface/list`, converted to
+// terraform-plugin-framework as the pattern to follow for new, config-driven resources.
+// Existing SDKv2 resources are left untouched.
+func NewInterfaceListResource() resource.Resource {
+	return &interfaceListResource{}
+}
+
+type interfaceListResource struct {
+	client *Client
+}
+
+// interfaceListResourceModel is the typed equivalent of the SDKv2 KeyName/PropCommentRw pair
+// used throughout provider_schema_helpers.go.
+type interfaceListResourceModel struct {
+	Id      types.String `tfsdk:"id"`
+	Name    types.String `tfsdk:"name"`
+	Comment types.String `tfsdk:"comment"`
+}
+
+func (r *interfaceListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_interface_list"
+}
+
+func (r *interfaceListResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates a RouterOS interface list (`/interface/list`).",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the interface list.",
+			},
+			"comment": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (r *interfaceListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected resource configure type",
+			fmt.Sprintf("Expected *routeros.Client, got: %T", req.ProviderData))
+		return
+	}
+
+	r.client = client
+}
+
+func (r *interfaceListResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan interfaceListResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+	if _, err := r.client.RunCommand(ctx, "/interface/list/add", "=name="+name, "=comment="+plan.Comment.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Unable to create interface list", err.Error())
+		return
+	}
+
+	plan.Id = plan.Name
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *interfaceListResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state interfaceListResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reply, err := r.client.RunCommand(ctx, "/interface/list/print", "?name="+state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read interface list", err.Error())
+		return
+	}
+
+	if !strings.Contains(reply, "!re") {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *interfaceListResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan interfaceListResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := r.client.RunCommand(ctx, "/interface/list/set", "=numbers="+plan.Id.ValueString(), "=comment="+plan.Comment.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Unable to update interface list", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *interfaceListResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state interfaceListResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := r.client.RunCommand(ctx, "/interface/list/remove", "=numbers="+state.Id.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Unable to delete interface list", err.Error())
+		return
+	}
+
+	// Terraform removes the resource from state automatically once Delete returns without
+	// diagnostics.
+}
@@ -0,0 +1,28 @@
+package routeros
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccFrameworkRouterOSInterfaceList_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + `
+resource "routeros_interface_list" "acc_test" {
+  name    = "acc-test-framework-list"
+  comment = "created by acceptance test"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("routeros_interface_list.acc_test", "name", "acc-test-framework-list"),
+					resource.TestCheckResourceAttr("routeros_interface_list.acc_test", "comment", "created by acceptance test"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,86 @@
+package routeros
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// resourceInterfaceListMember `/interface/list/member`. `routeros_interface_list` itself is
+// owned by the terraform-plugin-framework half of the provider (resource_interface_list_framework.go);
+// both of this resource's fields reference objects that must already exist rather than declare
+// new ones, which is exactly what CustomizeDiff/ComposeCustomizeDiff's plan-time checkers are for.
+func resourceInterfaceListMember() *schema.Resource {
+	return &schema.Resource{
+		Description: "Adds an interface to an interface list (`/interface/list/member`).",
+
+		CreateContext: resourceInterfaceListMemberCreate,
+		ReadContext:   resourceInterfaceListMemberRead,
+		DeleteContext: resourceInterfaceListMemberDelete,
+
+		Timeouts: DefaultTimeouts(),
+
+		CustomizeDiff: ComposeCustomizeDiff(CheckInterfaceExists, CheckInterfaceListExists),
+
+		Schema: map[string]*schema.Schema{
+			KeyInterface: PropInterfaceRw,
+			KeyList:      PropListRw,
+		},
+	}
+}
+
+func resourceInterfaceListMemberCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ctx, cancel := ResourceTimeoutContext(ctx, d, schema.TimeoutCreate)
+	defer cancel()
+
+	client := meta.(*Client)
+
+	iface := d.Get(KeyInterface).(string)
+	list := d.Get(KeyList).(string)
+
+	if _, err := client.RunCommand(ctx, "/interface/list/member/add", "=interface="+iface, "=list="+list); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(iface + "/" + list)
+
+	return resourceInterfaceListMemberRead(ctx, d, meta)
+}
+
+func resourceInterfaceListMemberRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ctx, cancel := ResourceTimeoutContext(ctx, d, schema.TimeoutRead)
+	ctx = ContextWithResourceID(ctx, d.Id())
+	defer cancel()
+
+	client := meta.(*Client)
+
+	reply, err := client.RunCommand(ctx, "/interface/list/member/print", "?interface="+d.Get(KeyInterface).(string), "?list="+d.Get(KeyList).(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if !strings.Contains(reply, "!re") {
+		d.SetId("")
+		return nil
+	}
+
+	return nil
+}
+
+func resourceInterfaceListMemberDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ctx, cancel := ResourceTimeoutContext(ctx, d, schema.TimeoutDelete)
+	ctx = ContextWithResourceID(ctx, d.Id())
+	defer cancel()
+
+	client := meta.(*Client)
+
+	if _, err := client.RunCommand(ctx, "/interface/list/member/remove", "=interface="+d.Get(KeyInterface).(string), "=list="+d.Get(KeyList).(string)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,32 @@
+package routeros
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccRouterOSInterfaceListMember_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + `
+resource "routeros_interface_list" "acc_test" {
+  name = "acc-test-list"
+}
+
+resource "routeros_interface_list_member" "acc_test" {
+  interface = "ether1"
+  list      = routeros_interface_list.acc_test.name
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("routeros_interface_list_member.acc_test", "interface", "ether1"),
+					resource.TestCheckResourceAttr("routeros_interface_list_member.acc_test", "list", "acc-test-list"),
+				),
+			},
+		},
+	})
+}
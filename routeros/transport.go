@@ -0,0 +1,175 @@
+package routeros
+
+import (
+	"context"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// LogSubsystem Subsystem name every trace/debug log line produced by loggingTransport is
+// emitted under; pair with TF_LOG_PROVIDER=trace to isolate ROS API chatter from the rest of
+// the provider's logs.
+const LogSubsystem = "routeros-api"
+
+// defaultRedactedFields Sentence fields masked unconditionally when ROS_LOG_REDACT is set, on
+// top of any user-supplied patterns.
+var defaultRedactedFields = []string{"password", "private-key", "pre-shared-key", "secret"}
+
+// resourceIDContextKey Context key CRUD handlers use to attach the resource's `.id` to the
+// context passed into Client.RunCommand, purely so loggingTransport can log it alongside the
+// resource path.
+type resourceIDContextKey struct{}
+
+// ContextWithResourceID Attaches id to ctx for loggingTransport to pick up.
+func ContextWithResourceID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, resourceIDContextKey{}, id)
+}
+
+// loggingTransport Wraps the ROS API connection so every sentence sent/received is logged at
+// TRACE/DEBUG through tflog, with sensitive fields masked. Injected once, in NewProvider's
+// ConfigureContextFunc, so it's transparent to every CRUD handler - Client.RunCommand calls
+// through it whenever it's set, instead of hitting the wire directly.
+type loggingTransport struct {
+	send func(ctx context.Context, words ...string) (string, error)
+
+	redact bool
+	extra  []*regexp.Regexp
+}
+
+// redactPatternsFromEnv Reads ROS_LOG_REDACT_PATTERNS as a comma-separated list of extra
+// regexes to redact, on top of defaultRedactedFields.
+func redactPatternsFromEnv() []string {
+	v := os.Getenv("ROS_LOG_REDACT_PATTERNS")
+	if v == "" {
+		return nil
+	}
+
+	return strings.Split(v, ",")
+}
+
+// NewLoggingTransport Wraps send (Client.rawRunCommand) with request/response logging.
+// ROS_LOG_REDACT enables masking of password/private-key/pre-shared-key/secret fields plus any
+// regex in extraPatterns.
+func NewLoggingTransport(send func(ctx context.Context, words ...string) (string, error), extraPatterns []string) *loggingTransport {
+	t := &loggingTransport{send: send}
+
+	if _, set := os.LookupEnv("ROS_LOG_REDACT"); set {
+		t.redact = true
+	}
+
+	for _, p := range extraPatterns {
+		if re, err := regexp.Compile(p); err == nil {
+			t.extra = append(t.extra, re)
+		}
+	}
+
+	return t
+}
+
+// Do Sends the sentence made up of words, logging the request and response (redacted, if
+// enabled) along with the resource path, `.id` (see ContextWithResourceID) and call latency.
+func (t *loggingTransport) Do(ctx context.Context, words ...string) (string, error) {
+	ctx = tflog.NewSubsystem(ctx, LogSubsystem)
+
+	path := ""
+	if len(words) > 0 {
+		path = words[0]
+	}
+	id, _ := ctx.Value(resourceIDContextKey{}).(string)
+
+	start := time.Now()
+	tflog.SubsystemTrace(ctx, LogSubsystem, "sending sentence", map[string]interface{}{
+		"path":     path,
+		"id":       id,
+		"sentence": strings.Join(t.redactWords(words), " "),
+	})
+
+	reply, err := t.send(ctx, words...)
+
+	fields := map[string]interface{}{
+		"path":       path,
+		"id":         id,
+		"latency_ms": time.Since(start).Milliseconds(),
+	}
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.SubsystemDebug(ctx, LogSubsystem, "sentence failed", fields)
+		return "", err
+	}
+
+	fields["reply"] = t.redactSentence(reply)
+	tflog.SubsystemTrace(ctx, LogSubsystem, "received reply", fields)
+
+	return reply, nil
+}
+
+// redactWords Masks `=field=value` pairs for any field in defaultRedactedFields (and any extra
+// user pattern) when ROS_LOG_REDACT is set, working directly on the caller's word slice instead
+// of a space-joined sentence - a value containing a space is still masked in full, since it
+// never gets re-split on the same spaces it was joined with.
+func (t *loggingTransport) redactWords(words []string) []string {
+	if !t.redact {
+		return words
+	}
+
+	redacted := make([]string, len(words))
+	for i, w := range words {
+		if isRedactedField(w, t.extra) {
+			redacted[i] = redactWord(w)
+		} else {
+			redacted[i] = w
+		}
+	}
+
+	return redacted
+}
+
+// redactSentence Same masking as redactWords, for callers that only have an already-joined
+// sentence (e.g. a reply string from RunCommand). A sensitive value containing a space is only
+// masked up to its first word in that case, since word boundaries lost in the join can't be
+// recovered; prefer redactWords wherever the original words are still available.
+func (t *loggingTransport) redactSentence(sentence string) string {
+	if !t.redact {
+		return sentence
+	}
+
+	return strings.Join(t.redactWords(strings.Split(sentence, " ")), " ")
+}
+
+func isRedactedField(word string, extra []*regexp.Regexp) bool {
+	if !strings.HasPrefix(word, "=") {
+		return false
+	}
+
+	field := strings.TrimPrefix(word, "=")
+	if idx := strings.Index(field, "="); idx >= 0 {
+		field = field[:idx]
+	}
+
+	for _, f := range defaultRedactedFields {
+		if field == f {
+			return true
+		}
+	}
+
+	for _, re := range extra {
+		if re.MatchString(field) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func redactWord(word string) string {
+	idx := strings.Index(word[1:], "=")
+	if idx < 0 {
+		return word
+	}
+
+	return word[:idx+2] + "***"
+}
@@ -0,0 +1,59 @@
+package routeros
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestLoggingTransport_RedactsSensitiveFields(t *testing.T) {
+	t.Setenv("ROS_LOG_REDACT", "1")
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	send := func(_ context.Context, words ...string) (string, error) {
+		return "!done =password=hunter2 =comment=fine", nil
+	}
+
+	transport := NewLoggingTransport(send, nil)
+
+	if _, err := transport.Do(ctx, "/interface/list/add", "=name=wan", "=password=hunter2"); err != nil {
+		t.Fatalf("Do: %v", err)
+	}
+
+	logged := output.String()
+	if strings.Contains(logged, "hunter2") {
+		t.Fatalf("sensitive word leaked into the tflog sink:\n%s", logged)
+	}
+}
+
+func TestLoggingTransport_NoRedactionWhenDisabled(t *testing.T) {
+	os.Unsetenv("ROS_LOG_REDACT")
+
+	send := func(_ context.Context, words ...string) (string, error) {
+		return "!done", nil
+	}
+
+	transport := NewLoggingTransport(send, nil)
+
+	sentence := transport.redactSentence("=password=hunter2")
+	if sentence != "=password=hunter2" {
+		t.Fatalf("expected no redaction when ROS_LOG_REDACT is unset, got %q", sentence)
+	}
+}
+
+func TestLoggingTransport_ExtraPattern(t *testing.T) {
+	t.Setenv("ROS_LOG_REDACT", "1")
+
+	transport := NewLoggingTransport(nil, []string{"^api-key$"})
+
+	sentence := transport.redactSentence("=api-key=topsecret =comment=fine")
+	if strings.Contains(sentence, "topsecret") {
+		t.Fatalf("expected user-supplied pattern to redact api-key, got %q", sentence)
+	}
+}
@@ -0,0 +1,136 @@
+package routeros
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const KeyList = "list"
+
+// PropListRw Name of an existing `/interface/list` a resource attaches to, e.g. an interface
+// list member. Distinct from KeyName, which is a resource's own name.
+var PropListRw = &schema.Schema{
+	Type:        schema.TypeString,
+	Required:    true,
+	Description: "Name of an existing interface list.",
+}
+
+// planChecker Probes RouterOS during `terraform plan` to confirm that a value referenced by a
+// field (an interface name, a firewall list, ...) actually exists, failing the plan instead of
+// leaving a runtime error for apply to discover. Composed into a CustomizeDiff with
+// ComposeCustomizeDiff. schema.CustomizeDiffFunc can only report a single error and has no way
+// to carry a diag.Warning, so a checker that cannot reach RouterOS to validate logs a warning
+// via tflog instead of failing the plan outright.
+type planChecker func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error
+
+// ComposeCustomizeDiff Builds a single CustomizeDiff out of per-field checkers. Returns nil
+// immediately when ROS_PLAN_VALIDATE=off, so `plan` keeps working offline.
+func ComposeCustomizeDiff(checkers ...planChecker) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if os.Getenv("ROS_PLAN_VALIDATE") == "off" {
+			return nil
+		}
+
+		for _, check := range checkers {
+			if err := check(ctx, d, meta); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+// planValidationCache Per-plan cache of probe results, keyed by "path=value" (e.g.
+// "/interface=ether1"), so a plan touching the same referenced object many times (a 500-rule
+// firewall, say) probes RouterOS at most once per distinct object. One instance lives on the
+// *Client the provider hands out via meta (see Client.planCache in provider.go), so it is
+// naturally shared by every checker call made during the same plan.
+type planValidationCache struct {
+	m sync.Map
+}
+
+// lookup Runs probe once per distinct key for the lifetime of the cache and remembers its
+// result (including an error) for subsequent calls.
+func (c *planValidationCache) lookup(key string, probe func() (bool, error)) (bool, error) {
+	type result struct {
+		exists bool
+		err    error
+	}
+
+	if v, ok := c.m.Load(key); ok {
+		r := v.(result)
+		return r.exists, r.err
+	}
+
+	exists, err := probe()
+	c.m.Store(key, result{exists: exists, err: err})
+
+	return exists, err
+}
+
+// CheckInterfaceExists Verifies that the value of KeyInterface (PropInterfaceRw) resolves to a
+// real interface via `/interface/print`, `?name=<value>`.
+func CheckInterfaceExists(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	return checkNameExists(ctx, d, meta, KeyInterface, "/interface", "interface")
+}
+
+// CheckInterfaceListExists Verifies that the value of KeyList (PropListRw) resolves to a real
+// interface list via `/interface/list/print`, `?name=<value>`.
+func CheckInterfaceListExists(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	return checkNameExists(ctx, d, meta, KeyList, "/interface/list", "interface list")
+}
+
+func checkNameExists(ctx context.Context, d *schema.ResourceDiff, meta interface{}, key, path, kind string) error {
+	name, ok := d.GetOk(key)
+	if !ok {
+		return nil
+	}
+	value := name.(string)
+	if value == "" {
+		return nil
+	}
+
+	client, ok := meta.(*Client)
+	if !ok || client.planCache == nil {
+		tflog.Warn(ctx, "could not validate "+kind+" against RouterOS", map[string]interface{}{
+			kind:    value,
+			"error": "provider meta did not carry a configured *routeros.Client",
+		})
+		return nil
+	}
+
+	exists, err := client.planCache.lookup(path+"="+value, func() (bool, error) {
+		return probeExists(ctx, client, path, value)
+	})
+	if err != nil {
+		tflog.Warn(ctx, "could not validate "+kind+" against RouterOS", map[string]interface{}{
+			kind:    value,
+			"error": err.Error(),
+		})
+		return nil
+	}
+
+	if !exists {
+		return errors.New("referenced " + kind + " " + value + " not found: " + path + "/print ?name=" + value + " returned no results")
+	}
+
+	return nil
+}
+
+// probeExists Runs a read-only `/print`, `?name=value` probe against RouterOS through the same
+// client CRUD handlers use, and reports whether it found a matching object.
+func probeExists(ctx context.Context, client *Client, path, value string) (bool, error) {
+	reply, err := client.RunCommand(ctx, path+"/print", "?name="+value)
+	if err != nil {
+		return false, err
+	}
+
+	return strings.Contains(reply, "!re"), nil
+}
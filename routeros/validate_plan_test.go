@@ -0,0 +1,89 @@
+package routeros
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+)
+
+// fakeInterfaceServer Accepts a single connection, completes /login, then replies "!re !done"
+// (object found) to every subsequent print and counts how many sentences it received.
+func fakeInterfaceServer(t *testing.T) (net.Listener, *int) {
+	t.Helper()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+
+	count := 0
+
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		c := &Client{conn: conn}
+
+		if _, err := c.readSentence(); err != nil {
+			return
+		}
+		if err := c.writeSentence("!done"); err != nil {
+			return
+		}
+
+		for {
+			if _, err := c.readSentence(); err != nil {
+				return
+			}
+			count++
+
+			if err := c.writeSentence("!re"); err != nil {
+				return
+			}
+			if err := c.writeSentence("!done"); err != nil {
+				return
+			}
+		}
+	}()
+
+	return ln, &count
+}
+
+func TestCheckInterfaceExists_CachesPerDistinctValue(t *testing.T) {
+	ln, probes := fakeInterfaceServer(t)
+	defer ln.Close()
+
+	client := &Client{HostURL: ln.Addr().String(), planCache: &planValidationCache{}}
+	if err := client.Dial(); err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	for i := 0; i < 5; i++ {
+		if _, err := client.planCache.lookup("/interface=ether1", func() (bool, error) {
+			return probeExists(ctx, client, "/interface", "ether1")
+		}); err != nil {
+			t.Fatalf("lookup: %v", err)
+		}
+	}
+
+	for i := 0; i < 3; i++ {
+		if _, err := client.planCache.lookup("/interface=ether2", func() (bool, error) {
+			return probeExists(ctx, client, "/interface", "ether2")
+		}); err != nil {
+			t.Fatalf("lookup: %v", err)
+		}
+	}
+
+	// 8 lookups across only 2 distinct values must hit RouterOS exactly twice.
+	if *probes != 2 {
+		t.Fatalf("expected 2 probes for 2 distinct values, RouterOS saw %d", *probes)
+	}
+}